@@ -0,0 +1,74 @@
+// Package errors provides a structured error type used throughout meshkit
+// and its consumers so that every error carries enough context (a stable
+// code, a severity, and operator-facing guidance) to be logged, displayed,
+// and triaged consistently.
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity classifies how urgently an Error should be acted upon.
+type Severity int
+
+const (
+	// Informational indicates the error is noteworthy but does not require action.
+	Informational Severity = iota
+	// Minor indicates a low-impact error.
+	Minor
+	// Warning indicates a condition that may lead to a more serious error.
+	Warning
+	// Alert indicates an error that should be investigated soon.
+	Alert
+	// Critical indicates an error that requires immediate attention.
+	Critical
+	// Emergency indicates the system is unusable.
+	Emergency
+)
+
+// Error is the structured error type returned by meshkit packages. Each
+// field is a distinct, independently useful piece of context rather than a
+// single opaque message, so callers (loggers, APIs, UIs) can surface
+// whichever parts are relevant to them.
+type Error struct {
+	Code                 string
+	Severity             Severity
+	ShortDescription     []string
+	LongDescription      []string
+	ProbableCause        []string
+	SuggestedRemediation []string
+}
+
+// New constructs an Error. The slices allow each section to be expressed as
+// multiple sentences/bullets without the caller having to pre-join them.
+func New(code string, severity Severity, shortDescription, longDescription, probableCause, suggestedRemediation []string) *Error {
+	return &Error{
+		Code:                 code,
+		Severity:             severity,
+		ShortDescription:     shortDescription,
+		LongDescription:      longDescription,
+		ProbableCause:        probableCause,
+		SuggestedRemediation: suggestedRemediation,
+	}
+}
+
+// Error implements the error interface by concatenating all of the
+// structured fields into a single human-readable string.
+func (e *Error) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Code: %s, Severity: %d", e.Code, e.Severity)
+	if len(e.ShortDescription) > 0 {
+		fmt.Fprintf(&b, ", Short Description: %s", strings.Join(e.ShortDescription, " "))
+	}
+	if len(e.LongDescription) > 0 {
+		fmt.Fprintf(&b, ", Long Description: %s", strings.Join(e.LongDescription, " "))
+	}
+	if len(e.ProbableCause) > 0 {
+		fmt.Fprintf(&b, ", Probable Cause: %s", strings.Join(e.ProbableCause, " "))
+	}
+	if len(e.SuggestedRemediation) > 0 {
+		fmt.Fprintf(&b, ", Suggested Remediation: %s", strings.Join(e.SuggestedRemediation, " "))
+	}
+	return b.String()
+}
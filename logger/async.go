@@ -0,0 +1,167 @@
+package logger
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// OverflowPolicy controls what happens when an async logger's buffer is
+// full and a new entry needs to be enqueued.
+type OverflowPolicy int
+
+const (
+	// Block makes the caller wait until buffer space frees up.
+	Block OverflowPolicy = iota
+	// DropNewest discards the entry that triggered the overflow.
+	DropNewest
+	// DropOldest discards the oldest buffered entry to make room for the
+	// new one.
+	DropOldest
+)
+
+// AsyncOptions enables non-blocking logging for hot paths (e.g. adapters
+// handling many mesh events) where a blocking write to a slow sink should
+// not stall the caller. The caller still waits when the buffer is full and
+// OverflowPolicy is Block (the zero value).
+type AsyncOptions struct {
+	Enabled        bool
+	BufferSize     int
+	OverflowPolicy OverflowPolicy
+	// FlushTimeout bounds how long Logger.Flush waits when called with a
+	// context that carries no deadline of its own.
+	FlushTimeout time.Duration
+}
+
+// Stats reports how an async logger's buffer has behaved since startup.
+type Stats struct {
+	Enqueued      uint64
+	Dropped       uint64
+	Written       uint64
+	HighWaterMark int64
+}
+
+const defaultAsyncBufferSize = 1024
+
+// asyncPipeline feeds a single background goroutine from a buffered
+// channel of closures, guaranteeing ordered, non-blocking (depending on
+// policy) writes for everything submitted to it.
+type asyncPipeline struct {
+	tasks  chan func()
+	done   chan struct{}
+	policy OverflowPolicy
+
+	enqueued  uint64
+	dropped   uint64
+	written   uint64
+	inFlight  int64
+	highWater int64
+}
+
+func newAsyncPipeline(opts AsyncOptions) *asyncPipeline {
+	size := opts.BufferSize
+	if size <= 0 {
+		size = defaultAsyncBufferSize
+	}
+	p := &asyncPipeline{
+		tasks:  make(chan func(), size),
+		done:   make(chan struct{}),
+		policy: opts.OverflowPolicy,
+	}
+	go p.run()
+	return p
+}
+
+func (p *asyncPipeline) run() {
+	defer close(p.done)
+	for task := range p.tasks {
+		task()
+		atomic.AddUint64(&p.written, 1)
+		atomic.AddInt64(&p.inFlight, -1)
+	}
+}
+
+// stop closes the pipeline's task channel and blocks until its worker
+// goroutine has drained every task already queued and exited. Callers
+// must not submit after calling stop.
+func (p *asyncPipeline) stop() {
+	close(p.tasks)
+	<-p.done
+}
+
+// submit enqueues task according to the configured OverflowPolicy.
+func (p *asyncPipeline) submit(task func()) {
+	atomic.AddUint64(&p.enqueued, 1)
+
+	switch p.policy {
+	case DropNewest:
+		select {
+		case p.tasks <- task:
+			p.accepted()
+		default:
+			atomic.AddUint64(&p.dropped, 1)
+		}
+	case DropOldest:
+		select {
+		case p.tasks <- task:
+			p.accepted()
+		default:
+			select {
+			case <-p.tasks:
+				atomic.AddInt64(&p.inFlight, -1)
+				atomic.AddUint64(&p.dropped, 1)
+			default:
+			}
+			select {
+			case p.tasks <- task:
+				p.accepted()
+			default:
+				atomic.AddUint64(&p.dropped, 1)
+			}
+		}
+	default: // Block
+		p.tasks <- task
+		p.accepted()
+	}
+}
+
+func (p *asyncPipeline) accepted() {
+	n := atomic.AddInt64(&p.inFlight, 1)
+	for {
+		cur := atomic.LoadInt64(&p.highWater)
+		if n <= cur || atomic.CompareAndSwapInt64(&p.highWater, cur, n) {
+			return
+		}
+	}
+}
+
+// flush submits a marker task and blocks until it has been processed,
+// which (because tasks run in FIFO order on a single goroutine) guarantees
+// every task submitted before flush was called has completed.
+func (p *asyncPipeline) flush(ctx context.Context) error {
+	done := make(chan struct{})
+	marker := func() { close(done) }
+
+	select {
+	case p.tasks <- marker:
+		p.accepted()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *asyncPipeline) stats() Stats {
+	return Stats{
+		Enqueued:      atomic.LoadUint64(&p.enqueued),
+		Dropped:       atomic.LoadUint64(&p.dropped),
+		Written:       atomic.LoadUint64(&p.written),
+		HighWaterMark: atomic.LoadInt64(&p.highWater),
+	}
+}
@@ -0,0 +1,123 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggerIntegration_FileOutput_Async(t *testing.T) {
+	var buf bytes.Buffer
+	log, err := New("testapp", Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+		Async: AsyncOptions{
+			Enabled:      true,
+			BufferSize:   16,
+			FlushTimeout: time.Second,
+		},
+	})
+	assert.NoError(t, err)
+	l := log.(*Logger)
+	l.UpdateLogOutput(&buf)
+
+	for i := 0; i < 50; i++ {
+		l.Infof("message %d", i)
+	}
+
+	assert.NoError(t, l.Flush(context.Background()))
+
+	for i := 0; i < 50; i++ {
+		assert.Contains(t, buf.String(), fmt.Sprintf("message %d", i))
+	}
+
+	stats := l.Stats()
+	assert.Equal(t, uint64(50), stats.Enqueued)
+	assert.GreaterOrEqual(t, stats.Written, uint64(50))
+	assert.Equal(t, uint64(0), stats.Dropped)
+}
+
+func TestLogger_Async_DropNewestUnderPressure(t *testing.T) {
+	release := make(chan struct{})
+	var buf bytes.Buffer
+
+	log, err := New("testapp", Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+		Async: AsyncOptions{
+			Enabled:        true,
+			BufferSize:     1,
+			OverflowPolicy: DropNewest,
+		},
+	})
+	assert.NoError(t, err)
+	l := log.(*Logger)
+	l.UpdateLogOutput(&buf)
+
+	// Block the single worker goroutine on the first entry until the
+	// buffer has had a chance to fill and overflow.
+	l.dispatch(func() { <-release })
+	for i := 0; i < 10; i++ {
+		l.Info("message")
+	}
+	close(release)
+
+	assert.NoError(t, l.Flush(context.Background()))
+	assert.Greater(t, l.Stats().Dropped, uint64(0))
+}
+
+func TestLogger_Close_StopsAsyncPipeline(t *testing.T) {
+	var buf bytes.Buffer
+	log, err := New("testapp", Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+		Async: AsyncOptions{
+			Enabled:    true,
+			BufferSize: 16,
+		},
+	})
+	assert.NoError(t, err)
+	l := log.(*Logger)
+	l.UpdateLogOutput(&buf)
+
+	for i := 0; i < 10; i++ {
+		l.Infof("message %d", i)
+	}
+
+	assert.NoError(t, l.Close())
+
+	for i := 0; i < 10; i++ {
+		assert.Contains(t, buf.String(), fmt.Sprintf("message %d", i))
+	}
+}
+
+func TestLogger_Close_NoopWhenSync(t *testing.T) {
+	log, err := New("testapp", Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, log.Close())
+}
+
+func TestLogger_Flush_NoopWhenSync(t *testing.T) {
+	log, err := New("testapp", Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, log.Flush(context.Background()))
+	assert.Equal(t, Stats{}, log.Stats())
+}
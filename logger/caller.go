@@ -0,0 +1,88 @@
+package logger
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// CallerFormat controls how a resolved call site is rendered into the
+// "caller" field.
+type CallerFormat int
+
+const (
+	// FileLine renders "file.go:42".
+	FileLine CallerFormat = iota
+	// FuncFileLine renders "funcName file.go:42".
+	FuncFileLine
+	// FullPath renders the absolute path returned by runtime.Caller,
+	// e.g. "/home/user/go/src/meshkit/logger/logger.go:42".
+	FullPath
+	// ShortPath renders the file relative to its package directory,
+	// e.g. "meshkit/logger/logger.go:42". When one of
+	// Options.CallerTrimPrefixes occurs in the path, everything before it is
+	// dropped and the prefix itself is kept.
+	ShortPath
+)
+
+// baseCallerSkip is the number of stack frames between captureCaller's own
+// runtime.Caller call and the code that called the public Logger method
+// (Info, Errorf, ...): captureCaller itself, the fieldsWithCaller helper
+// that calls it, and the public method that calls fieldsWithCaller.
+// Options.CallerSkip adds on top of this for wrapper libraries that sit
+// between the caller the operator cares about and the Logger method.
+const baseCallerSkip = 3
+
+// captureCaller resolves the call site that ultimately invoked the current
+// Logger method, honoring l.opts.CallerSkip, CallerFormat, and
+// CallerTrimPrefixes.
+func (l *Logger) captureCaller() string {
+	pc, file, line, ok := runtime.Caller(baseCallerSkip + l.opts.CallerSkip)
+	if !ok {
+		return ""
+	}
+
+	switch l.opts.CallerFormat {
+	case FullPath:
+		return fmt.Sprintf("%s:%d", file, line)
+	case FuncFileLine:
+		return fmt.Sprintf("%s %s:%d", funcName(pc), filepath.Base(file), line)
+	case ShortPath:
+		return fmt.Sprintf("%s:%d", shortenPath(file, l.opts.CallerTrimPrefixes), line)
+	default:
+		return fmt.Sprintf("%s:%d", filepath.Base(file), line)
+	}
+}
+
+func funcName(pc uintptr) string {
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return "unknown"
+	}
+	name := fn.Name()
+	if idx := strings.LastIndex(name, "/"); idx >= 0 {
+		name = name[idx+1:]
+	}
+	return name
+}
+
+// shortenPath renders file relative to its package directory. When one of
+// trimPrefixes is found in file, everything before it is dropped (so
+// "/home/user/go/src/github.com/meshery/meshkit/logger/logger.go" with
+// trim prefix "meshkit/" becomes "meshkit/logger/logger.go"). Otherwise it
+// falls back to "<package dir>/<file>.go".
+func shortenPath(file string, trimPrefixes []string) string {
+	for _, prefix := range trimPrefixes {
+		if idx := strings.Index(file, prefix); idx >= 0 {
+			return file[idx:]
+		}
+	}
+
+	dir := filepath.Dir(file)
+	pkg := filepath.Base(dir)
+	if pkg == "." || pkg == string(filepath.Separator) {
+		return filepath.Base(file)
+	}
+	return pkg + "/" + filepath.Base(file)
+}
@@ -0,0 +1,88 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogger_CallerInfo_FileLine(t *testing.T) {
+	log, err := New("testapp", Options{
+		Format:           JSONLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: true,
+	})
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	var buf bytes.Buffer
+	l.UpdateLogOutput(&buf)
+
+	_, _, wantLine, _ := runtime.Caller(0)
+	l.Info("caller test") // the call on the next line after runtime.Caller(0) above
+	wantLine++
+
+	var decoded map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, fmt.Sprintf("caller_test.go:%d", wantLine), decoded["caller"])
+}
+
+func TestLogger_CallerInfo_Disabled(t *testing.T) {
+	log, err := New("testapp", Options{
+		Format:           JSONLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+	})
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	var buf bytes.Buffer
+	l.UpdateLogOutput(&buf)
+	l.Info("no caller")
+
+	var decoded map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.NotContains(t, decoded, "caller")
+}
+
+func TestLogger_WithCallerSkip(t *testing.T) {
+	log, err := New("testapp", Options{
+		Format:           JSONLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: true,
+	})
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	var buf bytes.Buffer
+	l.UpdateLogOutput(&buf)
+
+	wrapped := l.WithCallerSkip(1).(*Logger)
+	wrapped.UpdateLogOutput(&buf)
+
+	logViaWrapper(t, wrapped)
+
+	var decoded map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	// With the extra skip frame, the reported caller should be this test
+	// function's call site, not logViaWrapper's.
+	assert.Contains(t, decoded["caller"], "caller_test.go")
+	assert.NotContains(t, decoded["caller"], fmt.Sprintf(":%d", logViaWrapperLine))
+}
+
+// logViaWrapperLine is kept in sync with the Info call inside
+// logViaWrapper so TestLogger_WithCallerSkip can assert the reported
+// caller is NOT that line.
+var logViaWrapperLine int
+
+func logViaWrapper(t *testing.T, l *Logger) {
+	t.Helper()
+	_, _, line, _ := runtime.Caller(0)
+	logViaWrapperLine = line + 1
+	l.Info("via wrapper")
+}
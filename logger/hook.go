@@ -0,0 +1,88 @@
+package logger
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Hook receives every Entry at one of the levels it reports. It is
+// decoupled from logrus's own Hook type, which operates on *logrus.Entry
+// and is fired directly by the logrus pipeline, so that integrations only
+// need to understand the stable Entry type.
+type Hook interface {
+	Levels() []logrus.Level
+	Fire(entry Entry) error
+}
+
+// levelsAtOrAbove returns every logrus.Level at least as severe as min
+// (logrus orders levels from most to least severe starting at 0).
+func levelsAtOrAbove(min logrus.Level) []logrus.Level {
+	return append([]logrus.Level(nil), logrus.AllLevels[:min+1]...)
+}
+
+// hookRegistry owns the set of Hooks a Logger (and every logger derived
+// from it) fires on every entry. It is shared by pointer across a Logger
+// family, mirroring sinkRegistry.
+type hookRegistry struct {
+	mx    sync.RWMutex
+	hooks []Hook
+}
+
+func newHookRegistry() *hookRegistry {
+	return &hookRegistry{}
+}
+
+func (r *hookRegistry) add(h Hook) {
+	r.mx.Lock()
+	r.hooks = append(r.hooks, h)
+	r.mx.Unlock()
+}
+
+func (r *hookRegistry) fire(entry Entry) {
+	r.mx.RLock()
+	hooks := make([]Hook, len(r.hooks))
+	copy(hooks, r.hooks)
+	r.mx.RUnlock()
+
+	for _, h := range hooks {
+		if !levelEnabled(h.Levels(), entry.Level) {
+			continue
+		}
+		_ = h.Fire(entry)
+	}
+}
+
+func levelEnabled(levels []logrus.Level, level logrus.Level) bool {
+	for _, l := range levels {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
+
+// userHookBridge adapts a hookRegistry into a logrus.Hook so it can be
+// attached to the underlying logrus loggers in New, alongside sinkHook.
+type userHookBridge struct {
+	registry *hookRegistry
+}
+
+func (b *userHookBridge) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (b *userHookBridge) Fire(e *logrus.Entry) error {
+	fields := make(map[string]interface{}, len(e.Data))
+	for k, v := range e.Data {
+		fields[k] = v
+	}
+	b.registry.fire(Entry{
+		Time:    e.Time,
+		Level:   e.Level,
+		Message: e.Message,
+		Fields:  fields,
+		Ctx:     e.Context,
+	})
+	return nil
+}
@@ -0,0 +1,47 @@
+package logger
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OTelSpanHook attaches entries as span events to the active span found on
+// Entry.Ctx (populated via Logger.InfoCtx/ErrorCtx), so log lines show up
+// alongside the trace that produced them. Entries with no context, or
+// whose context carries no recording span, are silently skipped.
+type OTelSpanHook struct {
+	MinLevel logrus.Level
+}
+
+// NewOTelSpanHook returns an OTelSpanHook attaching entries at or above
+// level.
+func NewOTelSpanHook(level logrus.Level) *OTelSpanHook {
+	return &OTelSpanHook{MinLevel: level}
+}
+
+// Levels implements Hook.
+func (h *OTelSpanHook) Levels() []logrus.Level {
+	return levelsAtOrAbove(h.MinLevel)
+}
+
+// Fire implements Hook.
+func (h *OTelSpanHook) Fire(entry Entry) error {
+	if entry.Ctx == nil {
+		return nil
+	}
+	span := trace.SpanFromContext(entry.Ctx)
+	if !span.IsRecording() {
+		return nil
+	}
+
+	attrs := make([]attribute.KeyValue, 0, len(entry.Fields)+1)
+	attrs = append(attrs, attribute.String("level", entry.Level.String()))
+	for k, v := range entry.Fields {
+		attrs = append(attrs, attribute.String(k, fmt.Sprintf("%v", v)))
+	}
+	span.AddEvent(entry.Message, trace.WithAttributes(attrs...))
+	return nil
+}
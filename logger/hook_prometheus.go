@@ -0,0 +1,43 @@
+package logger
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+var logEntriesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "meshkit_log_entries_total",
+	Help: "Total number of log entries emitted, labeled by level, app, and meshkit error code.",
+}, []string{"level", "app", "code"})
+
+// PrometheusHook increments meshkit_log_entries_total for every entry,
+// labeled by level, app, and the meshkit error code when the entry carries
+// one (see mergeMeshkitErrorFields).
+type PrometheusHook struct {
+	App string
+}
+
+// NewPrometheusHook returns a PrometheusHook for app, registering its
+// metric with reg (typically prometheus.DefaultRegisterer). Registering
+// the same metric twice (e.g. two Loggers for the same app) is not an
+// error.
+func NewPrometheusHook(app string, reg prometheus.Registerer) (*PrometheusHook, error) {
+	if err := reg.Register(logEntriesTotal); err != nil {
+		if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+			return nil, err
+		}
+	}
+	return &PrometheusHook{App: app}, nil
+}
+
+// Levels implements Hook.
+func (h *PrometheusHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire implements Hook.
+func (h *PrometheusHook) Fire(entry Entry) error {
+	code, _ := entry.Fields["code"].(string)
+	logEntriesTotal.WithLabelValues(entry.Level.String(), h.App, code).Inc()
+	return nil
+}
@@ -0,0 +1,89 @@
+package logger
+
+import (
+	"strings"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/sirupsen/logrus"
+
+	merrors "github.com/meshery/meshkit/errors"
+)
+
+// SentryHook reports entries to Sentry (or any Sentry-protocol-compatible
+// service, such as Glitchtip). Entries carrying meshkit errors.Error
+// fields (see mergeMeshkitErrorFields) are reported with a level derived
+// from the error's Severity, tagged with its Code, and with
+// ProbableCause/SuggestedRemediation attached as breadcrumbs.
+type SentryHook struct {
+	MinLevel logrus.Level
+}
+
+// NewSentryHook returns a SentryHook reporting entries at or above level.
+// Callers are responsible for calling sentry.Init beforehand.
+func NewSentryHook(level logrus.Level) *SentryHook {
+	return &SentryHook{MinLevel: level}
+}
+
+// Levels implements Hook.
+func (h *SentryHook) Levels() []logrus.Level {
+	return levelsAtOrAbove(h.MinLevel)
+}
+
+// Fire implements Hook.
+func (h *SentryHook) Fire(entry Entry) error {
+	event := sentry.NewEvent()
+	event.Message = entry.Message
+	event.Timestamp = entry.Time
+	event.Level = sentryLevelForLogrus(entry.Level)
+
+	code, _ := entry.Fields["code"].(string)
+	if code != "" {
+		event.Tags = map[string]string{"code": code}
+	}
+	if severity, ok := entry.Fields["severity"].(merrors.Severity); ok {
+		event.Level = sentryLevelForSeverity(severity)
+	}
+	if probableCause, ok := entry.Fields["probable_cause"].([]string); ok && len(probableCause) > 0 {
+		event.Breadcrumbs = append(event.Breadcrumbs, &sentry.Breadcrumb{
+			Category: "probable_cause",
+			Message:  strings.Join(probableCause, " "),
+			Level:    event.Level,
+		})
+	}
+	if remediation, ok := entry.Fields["suggested_remediation"].([]string); ok && len(remediation) > 0 {
+		event.Breadcrumbs = append(event.Breadcrumbs, &sentry.Breadcrumb{
+			Category: "suggested_remediation",
+			Message:  strings.Join(remediation, " "),
+			Level:    event.Level,
+		})
+	}
+
+	sentry.CaptureEvent(event)
+	return nil
+}
+
+func sentryLevelForLogrus(level logrus.Level) sentry.Level {
+	switch level {
+	case logrus.PanicLevel, logrus.FatalLevel:
+		return sentry.LevelFatal
+	case logrus.ErrorLevel:
+		return sentry.LevelError
+	case logrus.WarnLevel:
+		return sentry.LevelWarning
+	default:
+		return sentry.LevelInfo
+	}
+}
+
+func sentryLevelForSeverity(s merrors.Severity) sentry.Level {
+	switch {
+	case s >= merrors.Emergency:
+		return sentry.LevelFatal
+	case s >= merrors.Critical:
+		return sentry.LevelError
+	case s >= merrors.Alert:
+		return sentry.LevelWarning
+	default:
+		return sentry.LevelInfo
+	}
+}
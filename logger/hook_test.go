@@ -0,0 +1,105 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingHook struct {
+	levels []logrus.Level
+
+	mx      sync.Mutex
+	entries []Entry
+}
+
+func (h *recordingHook) Levels() []logrus.Level { return h.levels }
+
+func (h *recordingHook) Fire(entry Entry) error {
+	h.mx.Lock()
+	h.entries = append(h.entries, entry)
+	h.mx.Unlock()
+	return nil
+}
+
+func (h *recordingHook) Entries() []Entry {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+	out := make([]Entry, len(h.entries))
+	copy(out, h.entries)
+	return out
+}
+
+func TestLogger_AddHook_FiresOnMatchingLevel(t *testing.T) {
+	log, err := New("testapp", Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.DebugLevel),
+		EnableCallerInfo: false,
+	})
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	hook := &recordingHook{levels: []logrus.Level{logrus.InfoLevel}}
+	l.AddHook(hook)
+
+	l.Info("seen")
+	l.Debug("not seen")
+
+	assert.Eventually(t, func() bool {
+		return len(hook.Entries()) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "seen", hook.Entries()[0].Message)
+}
+
+func TestLogger_Error_MergesMeshkitErrorFieldsForHooks(t *testing.T) {
+	log, err := New("testapp", Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+	})
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	var buf bytes.Buffer
+	l.UpdateErrorLogOutput(&buf)
+
+	hook := &recordingHook{levels: logrus.AllLevels}
+	l.AddHook(hook)
+
+	l.Error(mError)
+
+	assert.Eventually(t, func() bool {
+		return len(hook.Entries()) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "code", hook.Entries()[0].Fields["code"])
+}
+
+func TestLogger_ErrorCtx_AttachesContext(t *testing.T) {
+	log, err := New("testapp", Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+	})
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	var buf bytes.Buffer
+	l.UpdateErrorLogOutput(&buf)
+
+	hook := &recordingHook{levels: logrus.AllLevels}
+	l.AddHook(hook)
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "trace-123")
+	l.ErrorCtx(ctx, mError)
+
+	assert.Eventually(t, func() bool {
+		return len(hook.Entries()) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "trace-123", hook.Entries()[0].Ctx.Value(ctxKey{}))
+}
@@ -0,0 +1,587 @@
+// Package logger provides the logging facade used across meshkit and its
+// adapters. It wraps logrus with a small, stable API so that consumers
+// never need to import logrus directly, and so that cross-cutting concerns
+// (structured output, contextual fields, caller info, ...) can evolve
+// without breaking call sites.
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	merrors "github.com/meshery/meshkit/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Format selects how log entries are rendered.
+type Format int
+
+const (
+	// TerminalLogFormat renders entries as human-readable terminal lines.
+	TerminalLogFormat Format = iota
+	// JSONLogFormat renders entries as one JSON object per line, suitable
+	// for ingestion by log-aggregation systems (ELK, Loki, ...).
+	JSONLogFormat
+)
+
+// Options configures a new Logger.
+type Options struct {
+	Format           Format
+	LogLevel         int
+	EnableCallerInfo bool
+	// CallerSkip adds extra stack frames to skip before resolving the
+	// caller, for wrapper libraries that call into Logger on a caller's
+	// behalf.
+	CallerSkip int
+	// CallerFormat controls how the resolved caller is rendered. The zero
+	// value is FileLine.
+	CallerFormat CallerFormat
+	// CallerTrimPrefixes is consulted by CallerFormat ShortPath to turn
+	// absolute file paths into package-relative ones.
+	CallerTrimPrefixes []string
+	// Output, when set, is used for both the standard and the error log
+	// output streams. When nil, standard output goes to os.Stdout and
+	// error output goes to os.Stderr.
+	Output io.Writer
+	// Async, when Enabled, runs all logging calls through a single
+	// background goroutine so that a slow sink stalls the caller only when
+	// the buffer is full and Async.OverflowPolicy is Block.
+	Async AsyncOptions
+	// Sampling, when Strategy is not NoSampling, throttles repeated
+	// entries before they are formatted or dispatched.
+	Sampling SamplingOptions
+}
+
+// Handler is the logging interface exposed to meshkit consumers.
+type Handler interface {
+	Info(description ...interface{})
+	Infof(format string, args ...interface{})
+	Debug(description ...interface{})
+	Debugf(format string, args ...interface{})
+	Warn(err error)
+	Warnf(format string, args ...interface{})
+	Error(err error)
+	Errorf(format string, args ...interface{})
+	Fatal(description ...interface{})
+	Fatalf(format string, args ...interface{})
+
+	// InfoCtx and ErrorCtx behave like Info and Error, but attach ctx to
+	// the entry so hooks that need the active trace span (e.g.
+	// OTelSpanHook) can find it.
+	InfoCtx(ctx context.Context, description ...interface{})
+	ErrorCtx(ctx context.Context, err error)
+
+	SetLevel(level logrus.Level)
+	GetLevel() logrus.Level
+
+	UpdateLogOutput(w io.Writer)
+	UpdateErrorLogOutput(w io.Writer)
+
+	// WithMDC sets key in the logger's Mapped Diagnostic Context. The MDC
+	// is merged into every subsequent entry emitted by this logger.
+	WithMDC(key string, value interface{}) Handler
+	// RemoveMDC removes key from the logger's MDC, if present.
+	RemoveMDC(key string)
+	// MDCClean clears the logger's entire MDC.
+	MDCClean()
+	// WithFields returns a child logger that inherits this logger's MDC
+	// plus the given fields, without mutating the parent.
+	WithFields(fields map[string]interface{}) Handler
+	// WithCallerSkip returns a child logger that skips n additional stack
+	// frames when resolving caller info, on top of Options.CallerSkip.
+	// Wrapper libraries that call through to Logger on behalf of their own
+	// callers use this so the reported caller is the wrapper's caller, not
+	// the wrapper itself.
+	WithCallerSkip(n int) Handler
+
+	// AddSink registers a Sink under name, replacing any sink already
+	// registered under that name. Every entry emitted by this logger (and
+	// any logger derived from it via WithFields) is fanned out to it.
+	AddSink(name string, s Sink)
+	// RemoveSink unregisters and closes the sink registered under name, if
+	// any.
+	RemoveSink(name string)
+
+	// AddHook registers h. Every entry emitted by this logger (and any
+	// logger derived from it via WithFields) is fired through it.
+	AddHook(h Hook)
+
+	// Flush blocks until every call made before it on this logger (and, in
+	// async mode, already queued) has been written, or ctx is done.
+	// It is a no-op, returning nil immediately, when Async is disabled.
+	Flush(ctx context.Context) error
+	// Stats reports this logger's async buffer counters. It returns the
+	// zero Stats when Async is disabled.
+	Stats() Stats
+
+	// SamplingStats reports suppressed/emitted counters per message
+	// template. It returns nil when Options.Sampling is disabled.
+	SamplingStats() map[string]SamplingCounts
+
+	// Close stops every background goroutine this logger owns: the async
+	// dispatch pipeline and the sampling summary loop, whichever are
+	// enabled, plus every sink registered via AddSink, which are stopped
+	// and closed the same way RemoveSink stops one. It blocks until all
+	// of that has drained. Close must not be called on a logger returned
+	// by WithFields or WithCallerSkip; call it once on the root Logger
+	// returned by New.
+	Close() error
+}
+
+// Logger is the default Handler implementation, backed by logrus.
+type Logger struct {
+	app    string
+	opts   Options
+	log    *logrus.Logger
+	errLog *logrus.Logger
+
+	mdcMx sync.RWMutex
+	mdc   map[string]interface{}
+
+	// sinks is shared with every logger derived from this one (via
+	// WithFields) so that AddSink/RemoveSink affect the whole family and
+	// the dispatch hook attached in New keeps working for children.
+	sinks *sinkRegistry
+
+	// async is nil unless Options.Async.Enabled, in which case it is
+	// shared with every logger derived from this one so that ordering and
+	// Flush/Stats stay meaningful across the family.
+	async *asyncPipeline
+
+	// hooks is shared with every logger derived from this one, mirroring
+	// sinks.
+	hooks *hookRegistry
+
+	// sampler is nil unless Options.Sampling.Strategy is set, in which
+	// case it is shared with every logger derived from this one so that
+	// dedup state and SamplingStats stay meaningful across the family.
+	sampler *sampler
+}
+
+// New creates a Logger for app using the given Options.
+func New(app string, opts Options) (Handler, error) {
+	log := logrus.New()
+	log.SetLevel(logrus.Level(opts.LogLevel))
+	log.SetFormatter(formatterFor(app, opts.Format))
+	if opts.Output != nil {
+		log.SetOutput(opts.Output)
+	} else {
+		log.SetOutput(os.Stdout)
+	}
+
+	errLog := logrus.New()
+	errLog.SetLevel(logrus.Level(opts.LogLevel))
+	errLog.SetFormatter(formatterFor(app, opts.Format))
+	if opts.Output != nil {
+		errLog.SetOutput(opts.Output)
+	} else {
+		errLog.SetOutput(os.Stderr)
+	}
+
+	l := &Logger{
+		app:    app,
+		opts:   opts,
+		log:    log,
+		errLog: errLog,
+		mdc:    map[string]interface{}{},
+		sinks:  newSinkRegistry(),
+		hooks:  newHookRegistry(),
+	}
+	if opts.Async.Enabled {
+		l.async = newAsyncPipeline(opts.Async)
+	}
+	if opts.Sampling.Strategy != NoSampling {
+		l.sampler = newSampler(opts.Sampling, l.emitSamplingSummary)
+	}
+
+	sinkBridge := &sinkHook{registry: l.sinks}
+	hookBridge := &userHookBridge{registry: l.hooks}
+	log.AddHook(sinkBridge)
+	log.AddHook(hookBridge)
+	errLog.AddHook(sinkBridge)
+	errLog.AddHook(hookBridge)
+
+	return l, nil
+}
+
+// formatterFor returns the logrus.Formatter matching format.
+func formatterFor(app string, format Format) logrus.Formatter {
+	switch format {
+	case JSONLogFormat:
+		return &JSONFormatter{App: app}
+	default:
+		return &TerminalFormatter{}
+	}
+}
+
+// fields returns a snapshot of the logger's MDC as logrus.Fields.
+func (l *Logger) fields() logrus.Fields {
+	l.mdcMx.RLock()
+	defer l.mdcMx.RUnlock()
+	f := make(logrus.Fields, len(l.mdc))
+	for k, v := range l.mdc {
+		f[k] = v
+	}
+	return f
+}
+
+// dispatch runs fn on the async pipeline when Async is enabled, or
+// synchronously otherwise.
+func (l *Logger) dispatch(fn func()) {
+	if l.async == nil {
+		fn()
+		return
+	}
+	l.async.submit(fn)
+}
+
+// fieldsWithCaller returns l.fields(), plus a "caller" entry resolving the
+// public method's caller when EnableCallerInfo is set. It must be called
+// directly from a public logging method so that baseCallerSkip lines up.
+func (l *Logger) fieldsWithCaller() logrus.Fields {
+	fields := l.fields()
+	if l.opts.EnableCallerInfo {
+		fields["caller"] = l.captureCaller()
+	}
+	return fields
+}
+
+func (l *Logger) Info(description ...interface{}) {
+	msg := fmt.Sprint(description...)
+	if !l.allowSample(logrus.InfoLevel, msg) {
+		return
+	}
+	fields := l.fieldsWithCaller()
+	l.dispatch(func() { l.log.WithFields(fields).Info(description...) })
+}
+
+func (l *Logger) Infof(format string, args ...interface{}) {
+	if !l.allowSample(logrus.InfoLevel, format) {
+		return
+	}
+	fields := l.fieldsWithCaller()
+	l.dispatch(func() { l.log.WithFields(fields).Infof(format, args...) })
+}
+
+func (l *Logger) Debug(description ...interface{}) {
+	msg := fmt.Sprint(description...)
+	if !l.allowSample(logrus.DebugLevel, msg) {
+		return
+	}
+	fields := l.fieldsWithCaller()
+	l.dispatch(func() { l.log.WithFields(fields).Debug(description...) })
+}
+
+func (l *Logger) Debugf(format string, args ...interface{}) {
+	if !l.allowSample(logrus.DebugLevel, format) {
+		return
+	}
+	fields := l.fieldsWithCaller()
+	l.dispatch(func() { l.log.WithFields(fields).Debugf(format, args...) })
+}
+
+func (l *Logger) Warn(err error) {
+	if !l.allowSampleForError(logrus.WarnLevel, err) {
+		return
+	}
+	fields := l.fieldsWithCaller()
+	l.dispatch(func() { l.log.WithFields(fields).Warn(err) })
+}
+
+func (l *Logger) Warnf(format string, args ...interface{}) {
+	if !l.allowSample(logrus.WarnLevel, format) {
+		return
+	}
+	fields := l.fieldsWithCaller()
+	l.dispatch(func() { l.log.WithFields(fields).Warnf(format, args...) })
+}
+
+// mergeMeshkitErrorFields adds err's Code, Severity, and description fields
+// to fields when err is a *merrors.Error, so formatters (in particular
+// JSONFormatter) and hooks can key off them as distinct fields instead of
+// relying on the concatenated message text.
+func mergeMeshkitErrorFields(fields logrus.Fields, err error) {
+	merr, ok := err.(*merrors.Error)
+	if !ok {
+		return
+	}
+	fields["code"] = merr.Code
+	fields["severity"] = merr.Severity
+	fields["short_description"] = merr.ShortDescription
+	fields["long_description"] = merr.LongDescription
+	fields["probable_cause"] = merr.ProbableCause
+	fields["suggested_remediation"] = merr.SuggestedRemediation
+}
+
+// sampleKeyForError returns the key used to sample err: a meshkit error's
+// stable Code when available, so parameterized long descriptions don't
+// defeat dedup, or err.Error() otherwise. A nil err (valid input to Warn
+// and Error) keys as the empty string rather than panicking.
+func sampleKeyForError(err error) string {
+	if err == nil {
+		return ""
+	}
+	if merr, ok := err.(*merrors.Error); ok {
+		return merr.Code
+	}
+	return err.Error()
+}
+
+// allowSample reports whether an entry at level keyed by template should
+// be emitted. It always returns true when sampling is disabled.
+func (l *Logger) allowSample(level logrus.Level, template string) bool {
+	if l.sampler == nil {
+		return true
+	}
+	return l.sampler.allow(level, template)
+}
+
+// allowSampleForError behaves like allowSample, but only computes err's
+// sampling key (sampleKeyForError) once sampling is confirmed enabled, so
+// Warn(nil)/Error(nil) stay cheap and safe when Options.Sampling is unset.
+func (l *Logger) allowSampleForError(level logrus.Level, err error) bool {
+	if l.sampler == nil {
+		return true
+	}
+	return l.sampler.allow(level, sampleKeyForError(err))
+}
+
+// emitSamplingSummary logs a periodic suppression summary for key,
+// bypassing the sampler so the summary itself is never suppressed.
+func (l *Logger) emitSamplingSummary(key string, suppressed uint64, window time.Duration) {
+	msg := fmt.Sprintf("suppressed %d duplicate entries in the last %.0fs", suppressed, window.Seconds())
+	fields := l.fields()
+	fields["sampled_template"] = key
+	l.dispatch(func() { l.log.WithFields(fields).Info(msg) })
+}
+
+// Error logs err at Error level. See mergeMeshkitErrorFields.
+func (l *Logger) Error(err error) {
+	if !l.allowSampleForError(logrus.ErrorLevel, err) {
+		return
+	}
+	fields := l.fieldsWithCaller()
+	mergeMeshkitErrorFields(fields, err)
+	l.dispatch(func() { l.errLog.WithFields(fields).Error(err) })
+}
+
+func (l *Logger) Errorf(format string, args ...interface{}) {
+	if !l.allowSample(logrus.ErrorLevel, format) {
+		return
+	}
+	fields := l.fieldsWithCaller()
+	l.dispatch(func() { l.errLog.WithFields(fields).Errorf(format, args...) })
+}
+
+// Fatal logs at Fatal level and terminates the process via os.Exit. When
+// Async is enabled, pending entries are flushed first so nothing queued
+// ahead of the fatal message is lost.
+func (l *Logger) Fatal(description ...interface{}) {
+	fields := l.fieldsWithCaller()
+	if l.async != nil {
+		_ = l.Flush(context.Background())
+	}
+	l.errLog.WithFields(fields).Fatal(description...)
+}
+
+func (l *Logger) Fatalf(format string, args ...interface{}) {
+	fields := l.fieldsWithCaller()
+	if l.async != nil {
+		_ = l.Flush(context.Background())
+	}
+	l.errLog.WithFields(fields).Fatalf(format, args...)
+}
+
+func (l *Logger) SetLevel(level logrus.Level) {
+	l.log.SetLevel(level)
+	l.errLog.SetLevel(level)
+}
+
+func (l *Logger) GetLevel() logrus.Level {
+	return l.log.GetLevel()
+}
+
+func (l *Logger) UpdateLogOutput(w io.Writer) {
+	l.log.SetOutput(w)
+}
+
+func (l *Logger) UpdateErrorLogOutput(w io.Writer) {
+	l.errLog.SetOutput(w)
+}
+
+func (l *Logger) WithMDC(key string, value interface{}) Handler {
+	l.mdcMx.Lock()
+	l.mdc[key] = value
+	l.mdcMx.Unlock()
+	return l
+}
+
+func (l *Logger) RemoveMDC(key string) {
+	l.mdcMx.Lock()
+	delete(l.mdc, key)
+	l.mdcMx.Unlock()
+}
+
+func (l *Logger) MDCClean() {
+	l.mdcMx.Lock()
+	l.mdc = map[string]interface{}{}
+	l.mdcMx.Unlock()
+}
+
+// WithFields returns a new Logger that shares this logger's output streams
+// and level but has its own MDC, seeded with the parent's current MDC plus
+// fields.
+func (l *Logger) WithFields(fields map[string]interface{}) Handler {
+	child := &Logger{
+		app:     l.app,
+		opts:    l.opts,
+		log:     l.log,
+		errLog:  l.errLog,
+		mdc:     l.fields(),
+		sinks:   l.sinks,
+		async:   l.async,
+		hooks:   l.hooks,
+		sampler: l.sampler,
+	}
+	for k, v := range fields {
+		child.mdc[k] = v
+	}
+	return child
+}
+
+// WithCallerSkip returns a new Logger identical to l but that skips n
+// additional stack frames when EnableCallerInfo is set.
+func (l *Logger) WithCallerSkip(n int) Handler {
+	opts := l.opts
+	opts.CallerSkip += n
+	return &Logger{
+		app:     l.app,
+		opts:    opts,
+		log:     l.log,
+		errLog:  l.errLog,
+		mdc:     l.fields(),
+		sinks:   l.sinks,
+		async:   l.async,
+		hooks:   l.hooks,
+		sampler: l.sampler,
+	}
+}
+
+func (l *Logger) AddSink(name string, s Sink) {
+	l.sinks.add(name, s)
+}
+
+func (l *Logger) RemoveSink(name string) {
+	l.sinks.remove(name)
+}
+
+func (l *Logger) AddHook(h Hook) {
+	l.hooks.add(h)
+}
+
+// InfoCtx logs at Info level with ctx attached to the entry so hooks that
+// need the active trace span can find it.
+func (l *Logger) InfoCtx(ctx context.Context, description ...interface{}) {
+	msg := fmt.Sprint(description...)
+	if !l.allowSample(logrus.InfoLevel, msg) {
+		return
+	}
+	fields := l.fieldsWithCaller()
+	l.dispatch(func() { l.log.WithContext(ctx).WithFields(fields).Info(description...) })
+}
+
+// ErrorCtx logs err at Error level with ctx attached to the entry, and
+// otherwise behaves like Error.
+func (l *Logger) ErrorCtx(ctx context.Context, err error) {
+	if !l.allowSampleForError(logrus.ErrorLevel, err) {
+		return
+	}
+	fields := l.fieldsWithCaller()
+	mergeMeshkitErrorFields(fields, err)
+	l.dispatch(func() { l.errLog.WithContext(ctx).WithFields(fields).Error(err) })
+}
+
+// Flush implements Handler.
+func (l *Logger) Flush(ctx context.Context) error {
+	if l.async == nil {
+		return nil
+	}
+	if _, ok := ctx.Deadline(); !ok && l.opts.Async.FlushTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, l.opts.Async.FlushTimeout)
+		defer cancel()
+	}
+	return l.async.flush(ctx)
+}
+
+// Stats implements Handler.
+func (l *Logger) Stats() Stats {
+	if l.async == nil {
+		return Stats{}
+	}
+	return l.async.stats()
+}
+
+// SamplingStats implements Handler.
+func (l *Logger) SamplingStats() map[string]SamplingCounts {
+	if l.sampler == nil {
+		return nil
+	}
+	return l.sampler.stats()
+}
+
+// Close implements Handler.
+func (l *Logger) Close() error {
+	if l.async != nil {
+		l.async.stop()
+	}
+	if l.sampler != nil {
+		l.sampler.stop()
+	}
+	l.sinks.stopAll()
+	return nil
+}
+
+// TerminalFormatter renders entries as human-readable terminal lines,
+// prefixing the caller location when present.
+type TerminalFormatter struct{}
+
+// Format implements logrus.Formatter.
+func (f *TerminalFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	var caller string
+	if c, ok := entry.Data["caller"]; ok {
+		caller = fmt.Sprintf("[%v] ", c)
+	}
+	return []byte(fmt.Sprintf("%s%s\n", caller, entry.Message)), nil
+}
+
+// JSONFormatter renders entries as one JSON object per line with stable
+// keys (ts, level, msg, caller, app), merging any additional entry fields
+// (MDC, meshkit error fields) directly into the top-level object so
+// log-aggregation systems can index them without regex parsing.
+type JSONFormatter struct {
+	App string
+}
+
+// Format implements logrus.Formatter.
+func (f *JSONFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	out := make(logrus.Fields, len(entry.Data)+4)
+	for k, v := range entry.Data {
+		out[k] = v
+	}
+	out["ts"] = entry.Time.Format(time.RFC3339Nano)
+	out["level"] = entry.Level.String()
+	out["msg"] = entry.Message
+	out["app"] = f.App
+
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
@@ -2,10 +2,12 @@ package logger
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	meshkitError "github.com/meshery/meshkit/errors"
 	"github.com/sirupsen/logrus"
@@ -195,3 +197,96 @@ func TestLoggerIntegration_FileOutput(t *testing.T) {
 	assert.Contains(t, string(data), "the probable cause of the error is Z")
 	assert.Contains(t, string(data), "try doing A, B, or C to remediate the error")
 }
+
+func TestJSONFormatter_Format(t *testing.T) {
+	formatter := &JSONFormatter{App: "testapp"}
+	entry := &logrus.Entry{
+		Time:    time.Now(),
+		Level:   logrus.InfoLevel,
+		Message: "json message",
+		Data:    logrus.Fields{"request_id": "abc123"},
+	}
+
+	b, err := formatter.Format(entry)
+	assert.NoError(t, err)
+
+	var decoded map[string]interface{}
+	assert.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, "json message", decoded["msg"])
+	assert.Equal(t, "testapp", decoded["app"])
+	assert.Equal(t, "info", decoded["level"])
+	assert.Equal(t, "abc123", decoded["request_id"])
+	assert.NotEmpty(t, decoded["ts"])
+}
+
+func TestJSONFormatter_MeshkitErrorFields(t *testing.T) {
+	opts := Options{
+		Format:           JSONLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+	}
+	log, err := New("testapp", opts)
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	var buf bytes.Buffer
+	l.UpdateErrorLogOutput(&buf)
+
+	l.Error(mError)
+
+	var decoded map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "code", decoded["code"])
+	assert.NotContains(t, decoded, "Short Description")
+}
+
+func TestLogger_MDC(t *testing.T) {
+	opts := Options{
+		Format:           JSONLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+	}
+	log, err := New("testapp", opts)
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	var buf bytes.Buffer
+	l.UpdateLogOutput(&buf)
+
+	l.WithMDC("request_id", "abc123")
+	l.Info("with mdc")
+
+	var decoded map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "abc123", decoded["request_id"])
+	buf.Reset()
+
+	l.RemoveMDC("request_id")
+	l.Info("mdc removed")
+	var decodedAfterRemoval map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &decodedAfterRemoval))
+	assert.NotContains(t, decodedAfterRemoval, "request_id")
+}
+
+func TestLogger_WithFields_InheritsParentMDC(t *testing.T) {
+	opts := Options{
+		Format:           JSONLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+	}
+	log, err := New("testapp", opts)
+	assert.NoError(t, err)
+	l := log.(*Logger)
+	l.WithMDC("request_id", "abc123")
+
+	child := l.WithFields(map[string]interface{}{"component": "adapter"})
+
+	var buf bytes.Buffer
+	child.(*Logger).UpdateLogOutput(&buf)
+	child.Info("child message")
+
+	var decoded map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "abc123", decoded["request_id"])
+	assert.Equal(t, "adapter", decoded["component"])
+}
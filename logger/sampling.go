@@ -0,0 +1,262 @@
+package logger
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// SamplingStrategy selects how Logger throttles repeated entries.
+type SamplingStrategy int
+
+const (
+	// NoSampling disables sampling: every entry is emitted.
+	NoSampling SamplingStrategy = iota
+	// TokenBucketSampling allows up to Rate entries per second, plus an
+	// initial Burst, through for each unique message template and level,
+	// dropping the rest.
+	TokenBucketSampling
+	// BurstSampling always emits the first Initial occurrences of a
+	// message template within Tick, then keeps only every Thereafter'th
+	// occurrence for the remainder of the window, as zap's sampler does.
+	BurstSampling
+)
+
+// SamplingOptions configures Logger's entry sampling. Sampling is applied
+// before an entry's fields are built or its caller resolved, so a
+// suppressed entry never pays for any of that work. Meshkit errors (see
+// mergeMeshkitErrorFields) are sampled by their stable Code rather than
+// their rendered message, so parameterized long descriptions don't defeat
+// dedup.
+type SamplingOptions struct {
+	Strategy SamplingStrategy
+
+	// Rate and Burst configure TokenBucketSampling: Rate is the steady-
+	// state entries/sec allowed per message template and level, and Burst
+	// is the bucket capacity. Burst defaults to Rate when unset.
+	Rate  float64
+	Burst int
+
+	// Initial, Thereafter, and Tick configure BurstSampling: the first
+	// Initial occurrences within Tick are always emitted, and every
+	// Thereafter'th occurrence after that is emitted.
+	Initial    int
+	Thereafter int
+	Tick       time.Duration
+
+	// SummaryInterval controls how often a
+	// "suppressed N duplicate entries in the last Ts" entry is logged for
+	// a message template that has had entries suppressed since the last
+	// summary. Zero disables summaries.
+	SummaryInterval time.Duration
+}
+
+// SamplingCounts reports how many entries under a message template have
+// been suppressed and emitted since sampling started.
+type SamplingCounts struct {
+	Suppressed uint64
+	Emitted    uint64
+}
+
+// sampler implements Logger's sampling. It is shared by pointer across a
+// Logger family, mirroring sinkRegistry and hookRegistry, so dedup state
+// and SamplingStats cover every logger derived via WithFields.
+type sampler struct {
+	opts SamplingOptions
+	// emit reports a periodic suppression summary for key. It is set by
+	// New to a closure that logs through the owning Logger, bypassing
+	// sampling itself so summaries are never suppressed.
+	emit func(key string, suppressed uint64, window time.Duration)
+
+	mx       sync.Mutex
+	counters map[string]*sampleCounter
+
+	closeCh chan struct{}
+}
+
+// sampleCounter holds one message template/level combination's throttle
+// state and running suppressed/emitted totals.
+type sampleCounter struct {
+	mx sync.Mutex
+
+	// token bucket state
+	tokens   float64
+	lastFill time.Time
+
+	// burst state
+	windowStart time.Time
+	windowCount int
+
+	suppressed          uint64
+	emitted             uint64
+	suppressedAtSummary uint64
+}
+
+func newSampler(opts SamplingOptions, emit func(key string, suppressed uint64, window time.Duration)) *sampler {
+	if opts.Tick <= 0 {
+		opts.Tick = time.Second
+	}
+	s := &sampler{
+		opts:     opts,
+		emit:     emit,
+		counters: map[string]*sampleCounter{},
+		closeCh:  make(chan struct{}),
+	}
+	if opts.SummaryInterval > 0 {
+		go s.summaryLoop(opts.SummaryInterval)
+	}
+	return s
+}
+
+// samplingKey identifies a message template for dedup purposes: the
+// message/format string for ordinary entries, or a meshkit error's Code
+// (see sampleKeyForError), combined with level so the same text logged at
+// two levels is tracked separately.
+func samplingKey(level logrus.Level, template string) string {
+	return fmt.Sprintf("%s|%s", level, template)
+}
+
+// allow reports whether an entry for template at level should be emitted,
+// consulting and updating the per-template counter for s.opts.Strategy.
+func (s *sampler) allow(level logrus.Level, template string) bool {
+	key := samplingKey(level, template)
+
+	s.mx.Lock()
+	c, ok := s.counters[key]
+	if !ok {
+		c = &sampleCounter{}
+		s.counters[key] = c
+	}
+	s.mx.Unlock()
+
+	if s.opts.Strategy == BurstSampling {
+		return c.allowBurst(s.opts)
+	}
+	return c.allowTokenBucket(s.opts)
+}
+
+// allowTokenBucket implements TokenBucketSampling for a single counter.
+func (c *sampleCounter) allowTokenBucket(opts SamplingOptions) bool {
+	rate := opts.Rate
+	if rate <= 0 {
+		rate = 1
+	}
+	burst := float64(opts.Burst)
+	if burst <= 0 {
+		burst = rate
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	now := time.Now()
+	if c.lastFill.IsZero() {
+		c.tokens = burst
+	} else if elapsed := now.Sub(c.lastFill).Seconds(); elapsed > 0 {
+		c.tokens += elapsed * rate
+		if c.tokens > burst {
+			c.tokens = burst
+		}
+	}
+	c.lastFill = now
+
+	if c.tokens < 1 {
+		c.suppressed++
+		return false
+	}
+	c.tokens--
+	c.emitted++
+	return true
+}
+
+// allowBurst implements BurstSampling for a single counter: it always
+// emits the first Initial occurrences within the current Tick window,
+// then every Thereafter'th occurrence for the rest of the window.
+func (c *sampleCounter) allowBurst(opts SamplingOptions) bool {
+	initial := opts.Initial
+	if initial <= 0 {
+		initial = 1
+	}
+	thereafter := opts.Thereafter
+	if thereafter <= 0 {
+		thereafter = 1
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	now := time.Now()
+	if c.windowStart.IsZero() || now.Sub(c.windowStart) >= opts.Tick {
+		c.windowStart = now
+		c.windowCount = 0
+	}
+	c.windowCount++
+
+	if c.windowCount <= initial || (c.windowCount-initial)%thereafter == 0 {
+		c.emitted++
+		return true
+	}
+	c.suppressed++
+	return false
+}
+
+// stats returns a snapshot of every counter's suppressed/emitted totals,
+// keyed the same way allow keys its internal counters.
+func (s *sampler) stats() map[string]SamplingCounts {
+	s.mx.Lock()
+	counters := make(map[string]*sampleCounter, len(s.counters))
+	for k, c := range s.counters {
+		counters[k] = c
+	}
+	s.mx.Unlock()
+
+	out := make(map[string]SamplingCounts, len(counters))
+	for k, c := range counters {
+		c.mx.Lock()
+		out[k] = SamplingCounts{Suppressed: c.suppressed, Emitted: c.emitted}
+		c.mx.Unlock()
+	}
+	return out
+}
+
+// summaryLoop periodically reports, via s.emit, how many entries have
+// been suppressed under each template since the last report.
+func (s *sampler) summaryLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.flushSummaries(interval)
+		case <-s.closeCh:
+			return
+		}
+	}
+}
+
+// stop stops the sampler's summary loop goroutine, if one was started.
+func (s *sampler) stop() {
+	close(s.closeCh)
+}
+
+func (s *sampler) flushSummaries(window time.Duration) {
+	s.mx.Lock()
+	counters := make(map[string]*sampleCounter, len(s.counters))
+	for k, c := range s.counters {
+		counters[k] = c
+	}
+	s.mx.Unlock()
+
+	for key, c := range counters {
+		c.mx.Lock()
+		delta := c.suppressed - c.suppressedAtSummary
+		c.suppressedAtSummary = c.suppressed
+		c.mx.Unlock()
+
+		if delta > 0 && s.emit != nil {
+			s.emit(key, delta, window)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package logger
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogger_Sampling_TokenBucket_ThrottlesDuplicates(t *testing.T) {
+	log, err := New("testapp", Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+		Sampling: SamplingOptions{
+			Strategy: TokenBucketSampling,
+			Rate:     1,
+			Burst:    2,
+		},
+	})
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	var buf bytes.Buffer
+	l.UpdateLogOutput(&buf)
+
+	for i := 0; i < 5; i++ {
+		l.Infof("duplicate message")
+	}
+
+	lines := strings.Count(buf.String(), "\n")
+	assert.Equal(t, 2, lines, "only Burst entries should pass before the bucket refills")
+
+	stats := l.SamplingStats()
+	counts, ok := stats[samplingKey(logrus.InfoLevel, "duplicate message")]
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), counts.Emitted)
+	assert.Equal(t, uint64(3), counts.Suppressed)
+}
+
+func TestLogger_Sampling_Burst_FirstNThenEveryMth(t *testing.T) {
+	log, err := New("testapp", Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+		Sampling: SamplingOptions{
+			Strategy:   BurstSampling,
+			Initial:    2,
+			Thereafter: 3,
+			Tick:       time.Minute,
+		},
+	})
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	var buf bytes.Buffer
+	l.UpdateLogOutput(&buf)
+
+	for i := 0; i < 8; i++ {
+		l.Infof("duplicate message")
+	}
+
+	// occurrences 1,2 (initial) and 5,8 (every 3rd after initial) emit: 4 total.
+	lines := strings.Count(buf.String(), "\n")
+	assert.Equal(t, 4, lines)
+}
+
+func TestLogger_Sampling_MeshkitError_KeyedByCode(t *testing.T) {
+	log, err := New("testapp", Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+		Sampling: SamplingOptions{
+			Strategy: TokenBucketSampling,
+			Rate:     1,
+			Burst:    1,
+		},
+	})
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	var buf bytes.Buffer
+	l.UpdateErrorLogOutput(&buf)
+
+	l.Error(mError)
+	l.Error(mError)
+
+	lines := strings.Count(buf.String(), "\n")
+	assert.Equal(t, 1, lines, "the second Error with the same Code should be suppressed")
+
+	stats := l.SamplingStats()
+	counts, ok := stats[samplingKey(logrus.ErrorLevel, mError.Code)]
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), counts.Emitted)
+	assert.Equal(t, uint64(1), counts.Suppressed)
+}
+
+func TestLogger_Sampling_Disabled_EmitsEverything(t *testing.T) {
+	log, err := New("testapp", Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+	})
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	var buf bytes.Buffer
+	l.UpdateLogOutput(&buf)
+
+	for i := 0; i < 5; i++ {
+		l.Infof("duplicate message")
+	}
+
+	lines := strings.Count(buf.String(), "\n")
+	assert.Equal(t, 5, lines)
+	assert.Nil(t, l.SamplingStats())
+}
+
+func TestLogger_Sampling_SummaryInterval_EmitsSuppressionSummary(t *testing.T) {
+	log, err := New("testapp", Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+		Sampling: SamplingOptions{
+			Strategy:        TokenBucketSampling,
+			Rate:            1,
+			Burst:           1,
+			SummaryInterval: 20 * time.Millisecond,
+		},
+	})
+	assert.NoError(t, err)
+	l := log.(*Logger)
+	defer func() { assert.NoError(t, l.Close()) }()
+
+	observer := NewObserverSink(logrus.InfoLevel)
+	l.AddSink("observer", observer)
+
+	for i := 0; i < 4; i++ {
+		l.Infof("duplicate message")
+	}
+
+	assert.Eventually(t, func() bool {
+		for _, entry := range observer.Entries() {
+			if strings.Contains(entry.Message, "suppressed 3 duplicate entries in the last") {
+				return true
+			}
+		}
+		return false
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestLogger_Sampling_NilError_DoesNotPanic(t *testing.T) {
+	log, err := New("testapp", Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+		Sampling: SamplingOptions{
+			Strategy: TokenBucketSampling,
+			Rate:     1,
+			Burst:    1,
+		},
+	})
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	assert.NotPanics(t, func() {
+		l.Warn(nil)
+		l.Error(nil)
+	})
+}
+
+func TestLogger_NilError_DoesNotPanicWithSamplingDisabled(t *testing.T) {
+	log, err := New("testapp", Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+	})
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	assert.NotPanics(t, func() {
+		l.Warn(nil)
+		l.Error(nil)
+	})
+}
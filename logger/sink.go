@@ -0,0 +1,176 @@
+package logger
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Entry is the sink-facing representation of a single log record. It is
+// decoupled from logrus.Entry so that Sink implementations never need to
+// import logrus.
+type Entry struct {
+	Time    time.Time
+	Level   logrus.Level
+	Message string
+	Fields  map[string]interface{}
+	// Ctx is the context passed to InfoCtx/ErrorCtx, if any. Hooks that
+	// need the active trace span (e.g. OTelSpanHook) read it from here.
+	Ctx context.Context
+}
+
+// Sink receives a copy of every log Entry at or above the level it
+// reports. Implementations must be safe for concurrent use. A Logger calls
+// Write from a single per-sink goroutine, and calls Close only after that
+// goroutine has finished its last Write (on RemoveSink, on replacement via
+// AddSink, or on Logger.Close).
+type Sink interface {
+	Write(entry Entry) error
+	Level() logrus.Level
+	Close() error
+}
+
+// defaultSinkBuffer bounds how many entries a slow sink may lag behind
+// before older, buffered entries are dropped to make room for new ones.
+const defaultSinkBuffer = 256
+
+// sinkRegistry owns the set of sinks a Logger (and every logger derived
+// from it) fans entries out to. It is shared by pointer across a Logger
+// family so that AddSink/RemoveSink on any of them is visible to all.
+type sinkRegistry struct {
+	mx      sync.RWMutex
+	workers map[string]*sinkWorker
+}
+
+func newSinkRegistry() *sinkRegistry {
+	return &sinkRegistry{workers: map[string]*sinkWorker{}}
+}
+
+func (r *sinkRegistry) add(name string, s Sink) {
+	w := newSinkWorker(s)
+
+	r.mx.Lock()
+	old, existed := r.workers[name]
+	r.workers[name] = w
+	r.mx.Unlock()
+
+	if existed {
+		old.stop()
+	}
+}
+
+func (r *sinkRegistry) remove(name string) {
+	r.mx.Lock()
+	w, ok := r.workers[name]
+	delete(r.workers, name)
+	r.mx.Unlock()
+
+	if ok {
+		w.stop()
+	}
+}
+
+// dispatch fans entry out to every registered sink without blocking the
+// caller: each sink has its own bounded buffer and worker goroutine.
+func (r *sinkRegistry) dispatch(entry Entry) {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+	for _, w := range r.workers {
+		w.submit(entry)
+	}
+}
+
+// stopAll stops every registered worker, draining its buffered entries
+// and closing its Sink, and removes it from the registry.
+func (r *sinkRegistry) stopAll() {
+	r.mx.Lock()
+	workers := r.workers
+	r.workers = map[string]*sinkWorker{}
+	r.mx.Unlock()
+
+	for _, w := range workers {
+		w.stop()
+	}
+}
+
+// sinkWorker pairs a Sink with a bounded, per-sink ring buffer and the
+// single goroutine draining it, so that a slow sink never blocks the
+// caller emitting a log entry nor any other sink.
+type sinkWorker struct {
+	sink Sink
+	ring chan Entry
+	done chan struct{}
+}
+
+func newSinkWorker(s Sink) *sinkWorker {
+	w := &sinkWorker{
+		sink: s,
+		ring: make(chan Entry, defaultSinkBuffer),
+		done: make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+func (w *sinkWorker) run() {
+	defer close(w.done)
+	for entry := range w.ring {
+		if entry.Level > w.sink.Level() {
+			continue
+		}
+		_ = w.sink.Write(entry)
+	}
+}
+
+// submit enqueues entry without blocking. If the ring buffer is full, the
+// oldest buffered entry is dropped to make room.
+func (w *sinkWorker) submit(entry Entry) {
+	select {
+	case w.ring <- entry:
+		return
+	default:
+	}
+
+	select {
+	case <-w.ring:
+	default:
+	}
+	select {
+	case w.ring <- entry:
+	default:
+	}
+}
+
+func (w *sinkWorker) stop() {
+	close(w.ring)
+	<-w.done
+	_ = w.sink.Close()
+}
+
+// sinkHook is the logrus.Hook that bridges logrus entries into the sink
+// registry. It is attached to both the standard and error logrus loggers
+// in New.
+type sinkHook struct {
+	registry *sinkRegistry
+}
+
+func (h *sinkHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *sinkHook) Fire(e *logrus.Entry) error {
+	fields := make(map[string]interface{}, len(e.Data))
+	for k, v := range e.Data {
+		fields[k] = v
+	}
+	h.registry.dispatch(Entry{
+		Time:    e.Time,
+		Level:   e.Level,
+		Message: e.Message,
+		Fields:  fields,
+		Ctx:     e.Context,
+	})
+	return nil
+}
@@ -0,0 +1,139 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// FileSink writes entries to a file, rotating it once it exceeds MaxSizeMB
+// or MaxAgeDays, mirroring the size/age rotation semantics of lumberjack.
+type FileSink struct {
+	Path       string
+	MaxSizeMB  int
+	MaxAgeDays int
+	MaxBackups int
+	Formatter  logrus.Formatter
+	MinLevel   logrus.Level
+
+	mx       sync.Mutex
+	file     *os.File
+	size     int64
+	openedAt time.Time
+}
+
+// NewFileSink returns a FileSink rotating path once it grows past
+// maxSizeMB megabytes or openedAt ages past maxAgeDays days, keeping at
+// most maxBackups rotated files (0 means keep all of them).
+func NewFileSink(path string, maxSizeMB, maxAgeDays, maxBackups int, level logrus.Level) *FileSink {
+	return &FileSink{
+		Path:       path,
+		MaxSizeMB:  maxSizeMB,
+		MaxAgeDays: maxAgeDays,
+		MaxBackups: maxBackups,
+		Formatter:  &TerminalFormatter{},
+		MinLevel:   level,
+	}
+}
+
+// Level implements Sink.
+func (s *FileSink) Level() logrus.Level {
+	return s.MinLevel
+}
+
+// Write implements Sink.
+func (s *FileSink) Write(entry Entry) error {
+	b, err := s.Formatter.Format(entryToLogrus(entry))
+	if err != nil {
+		return fmt.Errorf("logger: formatting entry for file sink: %w", err)
+	}
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if err := s.rotateIfNeededLocked(entry.Time, int64(len(b))); err != nil {
+		return err
+	}
+	if s.file == nil {
+		if err := s.openLocked(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.file.Write(b)
+	s.size += int64(n)
+	return err
+}
+
+// Close implements Sink.
+func (s *FileSink) Close() error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+func (s *FileSink) openLocked() error {
+	f, err := os.OpenFile(s.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("logger: opening log file %q: %w", s.Path, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	s.file = f
+	s.size = info.Size()
+	s.openedAt = time.Now()
+	return nil
+}
+
+func (s *FileSink) rotateIfNeededLocked(now time.Time, incoming int64) error {
+	if s.file == nil {
+		return nil
+	}
+
+	maxSize := int64(s.MaxSizeMB) * 1024 * 1024
+	sizeExceeded := s.MaxSizeMB > 0 && s.size+incoming > maxSize
+	ageExceeded := s.MaxAgeDays > 0 && now.Sub(s.openedAt) > time.Duration(s.MaxAgeDays)*24*time.Hour
+	if !sizeExceeded && !ageExceeded {
+		return nil
+	}
+
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+	s.file = nil
+
+	rotated := fmt.Sprintf("%s.%s", s.Path, now.UTC().Format("20060102T150405.000000000"))
+	if err := os.Rename(s.Path, rotated); err != nil {
+		return fmt.Errorf("logger: rotating log file %q: %w", s.Path, err)
+	}
+
+	if s.MaxBackups > 0 {
+		s.pruneBackupsLocked()
+	}
+	return nil
+}
+
+// pruneBackupsLocked removes the oldest rotated files beyond MaxBackups.
+func (s *FileSink) pruneBackupsLocked() {
+	matches, err := filepath.Glob(s.Path + ".*")
+	if err != nil || len(matches) <= s.MaxBackups {
+		return
+	}
+	sort.Strings(matches)
+	for _, old := range matches[:len(matches)-s.MaxBackups] {
+		_ = os.Remove(old)
+	}
+}
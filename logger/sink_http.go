@@ -0,0 +1,129 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// defaultHTTPBatchSize and defaultHTTPFlushInterval bound how long entries
+// sit in an HTTPSink's batch before being POSTed.
+const (
+	defaultHTTPBatchSize     = 100
+	defaultHTTPFlushInterval = 2 * time.Second
+)
+
+// HTTPSink batches entries as JSON and POSTs them to a collector endpoint,
+// so that one HTTP round trip is amortized over many log entries instead
+// of one request per entry.
+type HTTPSink struct {
+	URL           string
+	Client        *http.Client
+	BatchSize     int
+	FlushInterval time.Duration
+	MinLevel      logrus.Level
+
+	mx      sync.Mutex
+	batch   []Entry
+	flushCh chan struct{}
+	closeCh chan struct{}
+	closed  bool
+}
+
+// NewHTTPSink returns an HTTPSink posting batches of entries at or above
+// level to url.
+func NewHTTPSink(url string, level logrus.Level) *HTTPSink {
+	s := &HTTPSink{
+		URL:           url,
+		Client:        &http.Client{Timeout: 10 * time.Second},
+		BatchSize:     defaultHTTPBatchSize,
+		FlushInterval: defaultHTTPFlushInterval,
+		MinLevel:      level,
+		flushCh:       make(chan struct{}, 1),
+		closeCh:       make(chan struct{}),
+	}
+	go s.flushLoop()
+	return s
+}
+
+// Level implements Sink.
+func (s *HTTPSink) Level() logrus.Level {
+	return s.MinLevel
+}
+
+// Write implements Sink, buffering entry and triggering an async flush
+// once BatchSize is reached.
+func (s *HTTPSink) Write(entry Entry) error {
+	s.mx.Lock()
+	s.batch = append(s.batch, entry)
+	full := len(s.batch) >= s.BatchSize
+	s.mx.Unlock()
+
+	if full {
+		select {
+		case s.flushCh <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Close implements Sink, flushing any buffered entries before returning.
+func (s *HTTPSink) Close() error {
+	s.mx.Lock()
+	if s.closed {
+		s.mx.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.mx.Unlock()
+
+	close(s.closeCh)
+	return s.flush()
+}
+
+func (s *HTTPSink) flushLoop() {
+	ticker := time.NewTicker(s.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			_ = s.flush()
+		case <-s.flushCh:
+			_ = s.flush()
+		case <-s.closeCh:
+			return
+		}
+	}
+}
+
+func (s *HTTPSink) flush() error {
+	s.mx.Lock()
+	if len(s.batch) == 0 {
+		s.mx.Unlock()
+		return nil
+	}
+	batch := s.batch
+	s.batch = nil
+	s.mx.Unlock()
+
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return fmt.Errorf("logger: marshaling http sink batch: %w", err)
+	}
+
+	resp, err := s.Client.Post(s.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("logger: posting http sink batch: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("logger: http sink collector returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package logger
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ObserverSink records every entry it receives in memory so tests can
+// assert on emitted log entries without redirecting os.Stdout/os.Stderr or
+// parsing formatted output.
+type ObserverSink struct {
+	MinLevel logrus.Level
+
+	mx      sync.Mutex
+	entries []Entry
+}
+
+// NewObserverSink returns an ObserverSink capturing entries at or above
+// level.
+func NewObserverSink(level logrus.Level) *ObserverSink {
+	return &ObserverSink{MinLevel: level}
+}
+
+// Write implements Sink.
+func (s *ObserverSink) Write(entry Entry) error {
+	s.mx.Lock()
+	s.entries = append(s.entries, entry)
+	s.mx.Unlock()
+	return nil
+}
+
+// Level implements Sink.
+func (s *ObserverSink) Level() logrus.Level {
+	return s.MinLevel
+}
+
+// Close implements Sink. ObserverSink holds no resources to release.
+func (s *ObserverSink) Close() error {
+	return nil
+}
+
+// Entries returns a snapshot of every entry observed so far.
+func (s *ObserverSink) Entries() []Entry {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	out := make([]Entry, len(s.entries))
+	copy(out, s.entries)
+	return out
+}
+
+// Reset clears all previously observed entries.
+func (s *ObserverSink) Reset() {
+	s.mx.Lock()
+	s.entries = nil
+	s.mx.Unlock()
+}
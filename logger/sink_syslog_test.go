@@ -0,0 +1,64 @@
+//go:build !windows
+
+package logger
+
+import (
+	"bufio"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatRFC5424_RendersCompliantHeader(t *testing.T) {
+	entry := Entry{
+		Time:    time.Date(2026, 7, 30, 12, 0, 0, 0, time.UTC),
+		Level:   logrus.ErrorLevel,
+		Message: "disk is full",
+	}
+
+	got := formatRFC5424(entry, "myhost", "myapp", "123")
+
+	want := "<27>1 2026-07-30T12:00:00.000000Z myhost myapp 123 - - disk is full\n"
+	assert.Equal(t, want, got)
+}
+
+func TestRFC5424Severity_MapsLevels(t *testing.T) {
+	assert.Equal(t, 0, rfc5424Severity(logrus.PanicLevel))
+	assert.Equal(t, 2, rfc5424Severity(logrus.FatalLevel))
+	assert.Equal(t, 3, rfc5424Severity(logrus.ErrorLevel))
+	assert.Equal(t, 4, rfc5424Severity(logrus.WarnLevel))
+	assert.Equal(t, 6, rfc5424Severity(logrus.InfoLevel))
+	assert.Equal(t, 7, rfc5424Severity(logrus.DebugLevel))
+}
+
+func TestSyslogSink_Write_SendsRFC5424MessageOverConn(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	s := &SyslogSink{
+		conn:     client,
+		appName:  "myapp",
+		hostname: "myhost",
+		procID:   "123",
+		MinLevel: logrus.InfoLevel,
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, s.Write(Entry{
+			Time:    time.Date(2026, 7, 30, 12, 0, 0, 0, time.UTC),
+			Level:   logrus.InfoLevel,
+			Message: "hello",
+		}))
+	}()
+
+	line, err := bufio.NewReader(server).ReadString('\n')
+	assert.NoError(t, err)
+	assert.Equal(t, "<30>1 2026-07-30T12:00:00.000000Z myhost myapp 123 - - hello\n", line)
+	<-done
+}
@@ -0,0 +1,97 @@
+package logger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogger_AddSink_FansOutToObserver(t *testing.T) {
+	opts := Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+	}
+	log, err := New("testapp", opts)
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	observer := NewObserverSink(logrus.InfoLevel)
+	l.AddSink("observer", observer)
+
+	l.Info("fan out message")
+
+	assert.Eventually(t, func() bool {
+		return len(observer.Entries()) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	entries := observer.Entries()
+	assert.Equal(t, "fan out message", entries[0].Message)
+}
+
+func TestLogger_AddSink_RespectsPerSinkLevel(t *testing.T) {
+	opts := Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.DebugLevel),
+		EnableCallerInfo: false,
+	}
+	log, err := New("testapp", opts)
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	observer := NewObserverSink(logrus.WarnLevel)
+	l.AddSink("observer", observer)
+
+	l.Info("should be filtered")
+	l.Warn(assertError("should pass"))
+
+	assert.Eventually(t, func() bool {
+		return len(observer.Entries()) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestLogger_RemoveSink(t *testing.T) {
+	opts := Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+	}
+	log, err := New("testapp", opts)
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	observer := NewObserverSink(logrus.InfoLevel)
+	l.AddSink("observer", observer)
+	l.RemoveSink("observer")
+
+	l.Info("after removal")
+
+	time.Sleep(20 * time.Millisecond)
+	assert.Empty(t, observer.Entries())
+}
+
+func TestLogger_Close_StopsRegisteredSinks(t *testing.T) {
+	opts := Options{
+		Format:           TerminalLogFormat,
+		LogLevel:         int(logrus.InfoLevel),
+		EnableCallerInfo: false,
+	}
+	log, err := New("testapp", opts)
+	assert.NoError(t, err)
+	l := log.(*Logger)
+
+	observer := NewObserverSink(logrus.InfoLevel)
+	l.AddSink("observer", observer)
+
+	assert.NoError(t, l.Close())
+
+	l.Info("after close")
+	time.Sleep(20 * time.Millisecond)
+	assert.Empty(t, observer.Entries())
+}
+
+type assertError string
+
+func (e assertError) Error() string { return string(e) }
@@ -0,0 +1,65 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// WriterSink writes each entry, formatted by Formatter, to an underlying
+// io.Writer. Given os.Stdout or os.Stderr it serves as a stdout/stderr
+// sink, but it works with any io.Writer (a file, a bytes.Buffer in tests,
+// ...).
+type WriterSink struct {
+	Formatter logrus.Formatter
+	Out       io.Writer
+	MinLevel  logrus.Level
+
+	mx sync.Mutex
+}
+
+// NewWriterSink returns a WriterSink that writes entries at or above level
+// to w using formatter. A nil formatter defaults to *TerminalFormatter.
+func NewWriterSink(w io.Writer, level logrus.Level, formatter logrus.Formatter) *WriterSink {
+	if formatter == nil {
+		formatter = &TerminalFormatter{}
+	}
+	return &WriterSink{Formatter: formatter, Out: w, MinLevel: level}
+}
+
+// Write implements Sink.
+func (s *WriterSink) Write(entry Entry) error {
+	b, err := s.Formatter.Format(entryToLogrus(entry))
+	if err != nil {
+		return fmt.Errorf("logger: formatting entry for sink: %w", err)
+	}
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	_, err = s.Out.Write(b)
+	return err
+}
+
+// Level implements Sink.
+func (s *WriterSink) Level() logrus.Level {
+	return s.MinLevel
+}
+
+// Close implements Sink. WriterSink does not own Out, so Close is a no-op.
+func (s *WriterSink) Close() error {
+	return nil
+}
+
+// entryToLogrus adapts an Entry back to a *logrus.Entry so that existing
+// logrus.Formatter implementations (TerminalFormatter, JSONFormatter) can
+// be reused by sinks.
+func entryToLogrus(entry Entry) *logrus.Entry {
+	return &logrus.Entry{
+		Time:    entry.Time,
+		Level:   entry.Level,
+		Message: entry.Message,
+		Data:    entry.Fields,
+	}
+}